@@ -0,0 +1,294 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// AssetKind identifies which pipeline a job goes through: image generation
+// for sprites, TTS for voice lines, or music generation for scenes.
+type AssetKind string
+
+const (
+	AssetSprite AssetKind = "sprite"
+	AssetVoice  AssetKind = "voice"
+	AssetMusic  AssetKind = "music"
+)
+
+// AssetsConfig is GameConfig's asset generation request: what sprites,
+// voice lines, and music the generated game needs.
+type AssetsConfig struct {
+	Sprites []SpriteSpec `json:"sprites,omitempty"`
+	Voices  []VoiceSpec  `json:"voices,omitempty"`
+	Music   []MusicSpec  `json:"music,omitempty"`
+}
+
+// SpriteSpec requests one image asset from an AssetBackend.
+type SpriteSpec struct {
+	Name   string `json:"name"`
+	Prompt string `json:"prompt"`
+	Size   string `json:"size,omitempty"`
+}
+
+// VoiceSpec requests one narration/voice line from an AssetBackend.
+type VoiceSpec struct {
+	Character string `json:"character"`
+	Line      string `json:"line"`
+	Voice     string `json:"voice,omitempty"`
+}
+
+// MusicSpec requests one music cue from an AssetBackend.
+type MusicSpec struct {
+	Scene    string  `json:"scene"`
+	Prompt   string  `json:"prompt"`
+	Duration float64 `json:"duration,omitempty"`
+}
+
+// AssetJob is the kind-agnostic unit AssetDispatcher hands to a backend: a
+// prompt plus where the resulting bytes should be written.
+type AssetJob struct {
+	Kind       AssetKind
+	Prompt     string
+	OutputPath string
+	Extra      map[string]string
+}
+
+// AssetResult is what an AssetBackend returns for a job.
+type AssetResult struct {
+	Data []byte
+}
+
+// AssetBackend generates the bytes for one asset job. The one
+// implementation here, httpAssetBackend, is configured per kind by an HTTP
+// endpoint flag (--sprite-backend-url, --voice-backend-url,
+// --music-backend-url) read at first use, so swapping the sprite/voice/music
+// model is a config change rather than a code change. Asset backends are not
+// looked up in the engine backend registry.
+type AssetBackend interface {
+	Generate(ctx context.Context, job AssetJob) (AssetResult, error)
+}
+
+// httpAssetBackend implements AssetBackend by POSTing the job to an HTTP
+// endpoint (e.g. a Stable Diffusion, TTS, or MusicGen server) and reading
+// back the raw asset bytes.
+type httpAssetBackend struct {
+	url   string
+	model string
+}
+
+func (b *httpAssetBackend) Generate(ctx context.Context, job AssetJob) (AssetResult, error) {
+	body, err := json.Marshal(struct {
+		Model  string            `json:"model"`
+		Prompt string            `json:"prompt"`
+		Extra  map[string]string `json:"extra,omitempty"`
+	}{Model: b.model, Prompt: job.Prompt, Extra: job.Extra})
+	if err != nil {
+		return AssetResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.url, bytes.NewReader(body))
+	if err != nil {
+		return AssetResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return AssetResult{}, fmt.Errorf("asset backend %s: %w", b.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return AssetResult{}, fmt.Errorf("asset backend %s: %s", b.url, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return AssetResult{}, err
+	}
+	return AssetResult{Data: data}, nil
+}
+
+// AssetCache is a shared on-disk cache keyed by kind+prompt+model, so
+// regenerating the same sprite/voice/music line is cheap.
+type AssetCache struct {
+	dir string
+}
+
+// NewAssetCache returns a cache rooted at dir, creating it if necessary.
+func NewAssetCache(dir string) *AssetCache {
+	os.MkdirAll(dir, 0755)
+	return &AssetCache{dir: dir}
+}
+
+func (c *AssetCache) key(kind AssetKind, prompt, model string) string {
+	sum := sha256.Sum256([]byte(string(kind) + "|" + model + "|" + prompt))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns the cached bytes for (kind, prompt, model), if present.
+func (c *AssetCache) Get(kind AssetKind, prompt, model string) ([]byte, bool) {
+	data, err := os.ReadFile(filepath.Join(c.dir, c.key(kind, prompt, model)))
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// Put stores bytes for (kind, prompt, model).
+func (c *AssetCache) Put(kind AssetKind, prompt, model string, data []byte) error {
+	return os.WriteFile(filepath.Join(c.dir, c.key(kind, prompt, model)), data, 0644)
+}
+
+// AssetDispatcher fans an AssetsConfig out into jobs, runs them in
+// parallel against the right AssetBackend, and writes the resulting bytes
+// under outputDir. In dry-run mode (for deterministic tests) it writes a
+// JSON prompt manifest per job instead of calling any backend.
+type AssetDispatcher struct {
+	backends map[AssetKind]AssetBackend
+	cache    *AssetCache
+	dryRun   bool
+	model    map[AssetKind]string
+}
+
+// NewAssetDispatcher builds a dispatcher from backends, keyed by kind. A
+// kind with no entry in backends is unconfigured; jobs of that kind fail
+// individually rather than aborting the whole batch. model names
+// the per-kind model to request and cache against (e.g. from
+// --sprite-model); a nil map or missing entry defaults a kind to "".
+func NewAssetDispatcher(backends map[AssetKind]AssetBackend, cache *AssetCache, dryRun bool, model map[AssetKind]string) *AssetDispatcher {
+	if model == nil {
+		model = map[AssetKind]string{}
+	}
+	return &AssetDispatcher{backends: backends, cache: cache, dryRun: dryRun, model: model}
+}
+
+// Dispatch runs every job in assets concurrently and returns the paths
+// written under outputDir, sorted for determinism (jobs complete in
+// whatever order the backends respond in).
+func (d *AssetDispatcher) Dispatch(ctx context.Context, outputDir string, assets AssetsConfig) ([]string, error) {
+	jobs := jobsFor(assets)
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		written []string
+		errs    []error
+	)
+
+	for _, job := range jobs {
+		job := job
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			path, err := d.run(ctx, outputDir, job)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			written = append(written, path)
+		}()
+	}
+	wg.Wait()
+	sort.Strings(written)
+
+	if len(errs) > 0 {
+		return written, fmt.Errorf("asset generation: %d of %d jobs failed: %w", len(errs), len(jobs), errs[0])
+	}
+	return written, nil
+}
+
+func (d *AssetDispatcher) run(ctx context.Context, outputDir string, job AssetJob) (string, error) {
+	fullPath := filepath.Join(outputDir, job.OutputPath)
+
+	if d.dryRun {
+		manifestPath := fullPath + ".prompt.json"
+		manifest, err := json.MarshalIndent(job, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		if err := os.MkdirAll(filepath.Dir(manifestPath), 0755); err != nil {
+			return "", err
+		}
+		if err := os.WriteFile(manifestPath, manifest, 0644); err != nil {
+			return "", err
+		}
+		return manifestPath, nil
+	}
+
+	backend, ok := d.backends[job.Kind]
+	if !ok {
+		return "", fmt.Errorf("no asset backend configured for kind %q", job.Kind)
+	}
+	model := d.model[job.Kind]
+
+	if data, ok := d.cache.Get(job.Kind, job.Prompt, model); ok {
+		return d.write(fullPath, data)
+	}
+
+	result, err := backend.Generate(ctx, job)
+	if err != nil {
+		return "", err
+	}
+	d.cache.Put(job.Kind, job.Prompt, model, result.Data)
+	return d.write(fullPath, result.Data)
+}
+
+func (d *AssetDispatcher) write(fullPath string, data []byte) (string, error) {
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(fullPath, data, 0644); err != nil {
+		return "", err
+	}
+	return fullPath, nil
+}
+
+// jobsFor flattens an AssetsConfig into the jobs an AssetDispatcher runs,
+// assigning each an engine-appropriate asset path. Name/Character/Scene
+// come from caller-supplied config, so they're run through
+// sanitizeFilename before being joined into a path; otherwise a value like
+// "../../../etc/passwd" would let a job write outside OutputDirectory.
+func jobsFor(assets AssetsConfig) []AssetJob {
+	var jobs []AssetJob
+	for _, s := range assets.Sprites {
+		jobs = append(jobs, AssetJob{
+			Kind:       AssetSprite,
+			Prompt:     s.Prompt,
+			OutputPath: filepath.Join("assets/sprites", sanitizeFilename(s.Name)+".png"),
+			Extra:      map[string]string{"size": s.Size},
+		})
+	}
+	for _, v := range assets.Voices {
+		jobs = append(jobs, AssetJob{
+			Kind:       AssetVoice,
+			Prompt:     v.Line,
+			OutputPath: filepath.Join("assets/audio/voices", sanitizeFilename(v.Character)+"_"+sanitizeFilename(v.Line)+".wav"),
+			Extra:      map[string]string{"character": v.Character, "voice": v.Voice},
+		})
+	}
+	for _, m := range assets.Music {
+		jobs = append(jobs, AssetJob{
+			Kind:       AssetMusic,
+			Prompt:     m.Prompt,
+			OutputPath: filepath.Join("assets/audio/music", sanitizeFilename(m.Scene)+".wav"),
+			Extra:      map[string]string{"scene": m.Scene},
+		})
+	}
+	return jobs
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// DistributionManifest describes how a generated game launches and ships,
+// written as distribution.json next to the generated project so downstream
+// launchers can enumerate games, resolve per-OS launch commands, and
+// present them in a unified catalogue.
+type DistributionManifest struct {
+	Engine            string            `json:"engine"`
+	LaunchCmdPerOS    map[string]string `json:"launch_cmd_per_os"`
+	LaunchArgs        []string          `json:"launch_args,omitempty"`
+	SteamAppID        string            `json:"steam_app_id,omitempty"`
+	ItchProject       string            `json:"itch_project,omitempty"`
+	DiscordGroupID    string            `json:"discord_group_id,omitempty"`
+	InstallIDRequired bool              `json:"install_id_required,omitempty"`
+	AssetsToBundle    []string          `json:"assets_to_bundle,omitempty"`
+}
+
+// distributionManifestFilename is the file written inside every generated
+// project's output directory.
+const distributionManifestFilename = "distribution.json"
+
+// buildDistributionManifest derives a DistributionManifest for a generated
+// game from its engine and the files/assets it scaffolded. Launch commands
+// are the conventional ones for each engine's own build/run tooling.
+func buildDistributionManifest(engineName string, files []string) *DistributionManifest {
+	manifest := &DistributionManifest{
+		Engine:         engineName,
+		LaunchCmdPerOS: launchCommandsFor(engineName),
+		AssetsToBundle: assetPaths(files),
+	}
+	return manifest
+}
+
+func launchCommandsFor(engine string) map[string]string {
+	switch engine {
+	case "bevy":
+		return map[string]string{
+			"windows": "target/release/game.exe",
+			"linux":   "target/release/game",
+			"darwin":  "target/release/game",
+		}
+	case "godot":
+		return map[string]string{
+			"windows": "godot --path . --main-pack project.godot",
+			"linux":   "godot --path . --main-pack project.godot",
+			"darwin":  "godot --path . --main-pack project.godot",
+		}
+	case "arcade":
+		return map[string]string{
+			"windows": "python main.py",
+			"linux":   "python3 main.py",
+			"darwin":  "python3 main.py",
+		}
+	default:
+		return map[string]string{
+			"windows": "main.py",
+			"linux":   "main.py",
+			"darwin":  "main.py",
+		}
+	}
+}
+
+func assetPaths(files []string) []string {
+	var assets []string
+	for _, f := range files {
+		if containsDir(f, "assets") {
+			assets = append(assets, f)
+		}
+	}
+	return assets
+}
+
+func containsDir(path, dir string) bool {
+	for d := path; d != "." && d != string(filepath.Separator); d = filepath.Dir(d) {
+		if filepath.Base(d) == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// writeDistributionManifest marshals manifest and writes it to
+// distribution.json inside outputDir.
+func writeDistributionManifest(outputDir string, manifest *DistributionManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outputDir, distributionManifestFilename), data, 0644)
+}
+
+// PackageFormat is one of the archive/upload formats ai_game_dev_package
+// supports.
+type PackageFormat string
+
+const (
+	PackageZip        PackageFormat = "zip"
+	PackageSteampipe  PackageFormat = "steampipe"
+	PackageItchButler PackageFormat = "itch-butler"
+	PackageAppImage   PackageFormat = "appimage"
+)
+
+// PackageProject packages outputDir in the given format. For PackageZip it
+// returns the path of the produced archive; for the tool-driven formats
+// (steampipe, itch-butler, appimage) it returns outputDir, the directory the
+// tool was run in.
+func PackageProject(outputDir string, format PackageFormat) (string, error) {
+	switch format {
+	case PackageZip:
+		return packageZip(outputDir)
+	case PackageSteampipe:
+		return packageViaTool(outputDir, "steamcmd", "+run_app_build", filepath.Join(outputDir, "steam_build.vdf"))
+	case PackageItchButler:
+		return packageViaTool(outputDir, "butler", "push", outputDir)
+	case PackageAppImage:
+		return packageViaTool(outputDir, "appimagetool", outputDir)
+	default:
+		return "", fmt.Errorf("unsupported package format %q", format)
+	}
+}
+
+func packageZip(outputDir string) (string, error) {
+	archivePath := outputDir + ".zip"
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+
+	zw := zip.NewWriter(archive)
+	defer zw.Close()
+
+	err = filepath.Walk(outputDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(outputDir, path)
+		if err != nil {
+			return err
+		}
+		w, err := zw.Create(rel)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(w, f)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return archivePath, nil
+}
+
+// packageViaTool shells out to a platform packaging/upload tool (steamcmd,
+// butler, appimagetool) when it's present on PATH and credentials (if any)
+// are already configured in the environment for it.
+func packageViaTool(outputDir, tool string, args ...string) (string, error) {
+	binPath, err := exec.LookPath(tool)
+	if err != nil {
+		return "", fmt.Errorf("%s not found on PATH; install it or use format \"zip\"", tool)
+	}
+
+	cmd := exec.Command(binPath, args...)
+	cmd.Dir = outputDir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s failed: %w", tool, err)
+	}
+	return outputDir, nil
+}
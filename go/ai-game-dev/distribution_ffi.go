@@ -0,0 +1,40 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import "encoding/json"
+
+//export ai_game_dev_package
+func ai_game_dev_package(instanceID C.int, format *C.char) *C.char {
+	if !initialized {
+		return C.CString(`{"error": "Library not initialized"}`)
+	}
+
+	instance, exists := lookupGameInstance(int(instanceID))
+	if !exists {
+		return C.CString(`{"error": "Invalid instance ID"}`)
+	}
+	if !instance.Generated || instance.Result == nil {
+		return C.CString(`{"error": "Game not yet generated"}`)
+	}
+
+	path, err := PackageProject(instance.Result.OutputDirectory, PackageFormat(C.GoString(format)))
+	if err != nil {
+		lastError = err.Error()
+		errJSON, _ := json.Marshal(map[string]string{"error": err.Error()})
+		return C.CString(string(errJSON))
+	}
+
+	instancesMu.Lock()
+	if instance.Packages == nil {
+		instance.Packages = map[string]string{}
+	}
+	instance.Packages[C.GoString(format)] = path
+	instancesMu.Unlock()
+
+	pathJSON, _ := json.Marshal(map[string]string{"path": path})
+	return C.CString(string(pathJSON))
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"ai-game-dev/go/ai-game-dev/backend"
+	pb "ai-game-dev/go/ai-game-dev/backend/gamebackendpb"
+)
+
+// generateViaBackend drives an external engine backend's Generate RPC to
+// completion and returns the files it reports, for generateFileList, which
+// only wants the final list. The backend's own progress events are not
+// forwarded to streaming callers.
+func generateViaBackend(b *backend.Backend, description string, config GameConfig) ([]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := b.Client.Generate(ctx, &pb.GameConfig{
+		Description:    description,
+		Engine:         b.Engine,
+		Complexity:     config.Complexity,
+		TargetAudience: config.TargetAudience,
+		Features:       config.Features,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("generate via backend %q: %w", b.Engine, err)
+	}
+
+	var files []string
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("backend %q stream: %w", b.Engine, err)
+		}
+		if event.Error != "" {
+			return nil, fmt.Errorf("backend %q: %s", b.Engine, event.Error)
+		}
+		files = append(files, event.FilesGenerated...)
+		if event.Done {
+			break
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TemplateFile is one file a template manifest scaffolds. Path is rendered
+// as a text/template with the generation description/config as context, so
+// a manifest can branch on complexity, target audience, or features. The
+// generator only tracks scaffolded paths, not file contents (see
+// Regenerate in project.go), so manifests declare a file's permissions via
+// Mode but not its body.
+type TemplateFile struct {
+	Path string `yaml:"path"`
+	Mode string `yaml:"mode,omitempty"`
+}
+
+// TemplateManifest describes one gallery entry, modeled after LocalAI's
+// model gallery manifests.
+type TemplateManifest struct {
+	Name             string            `yaml:"name"`
+	Engine           string            `yaml:"engine"`
+	Genre            string            `yaml:"genre"`
+	Files            []TemplateFile    `yaml:"files"`
+	Prompts          map[string]string `yaml:"prompts,omitempty"`
+	RequiredFeatures []string          `yaml:"required_features,omitempty"`
+	License          string            `yaml:"license,omitempty"`
+	Icon             string            `yaml:"icon,omitempty"`
+}
+
+// remoteIndexEntry is one row of a remote gallery index: the manifest's
+// name plus the URL it can be fetched from.
+type remoteIndexEntry struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+}
+
+// Gallery is a lookup of template name to TemplateManifest, loaded from a
+// local directory of YAML manifests plus whatever remote indexes have been
+// configured. Installed manifests are cached under dir so repeated
+// generations don't re-fetch them.
+type Gallery struct {
+	mu            sync.RWMutex
+	dir           string
+	remoteIndexes []string
+	templates     map[string]*TemplateManifest
+}
+
+// NewGallery returns a Gallery backed by dir (used both to load bundled
+// manifests and to cache installed ones) and the given remote index URLs.
+func NewGallery(dir string, remoteIndexes []string) *Gallery {
+	g := &Gallery{
+		dir:           dir,
+		remoteIndexes: remoteIndexes,
+		templates:     make(map[string]*TemplateManifest),
+	}
+	g.loadDir(dir)
+	return g
+}
+
+func (g *Gallery) loadDir(dir string) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !(strings.HasSuffix(entry.Name(), ".yaml") || strings.HasSuffix(entry.Name(), ".yml")) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		var manifest TemplateManifest
+		if err := yaml.Unmarshal(data, &manifest); err != nil {
+			continue
+		}
+		g.mu.Lock()
+		g.templates[manifest.Name] = &manifest
+		g.mu.Unlock()
+	}
+}
+
+// List returns every manifest currently known to the gallery, sorted by
+// name so repeated calls return a stable order.
+func (g *Gallery) List() []*TemplateManifest {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	out := make([]*TemplateManifest, 0, len(g.templates))
+	for _, m := range g.templates {
+		out = append(out, m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Get looks up a manifest by name.
+func (g *Gallery) Get(name string) (*TemplateManifest, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	m, ok := g.templates[name]
+	return m, ok
+}
+
+// MatchFeatures returns a manifest for engine whose required_features are
+// all present in features, for callers that don't pick a template
+// explicitly. When several manifests match, which one is returned is not
+// defined.
+func (g *Gallery) MatchFeatures(engine string, features []string) (*TemplateManifest, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for _, m := range g.templates {
+		if engine != "" && engine != "auto" && m.Engine != engine {
+			continue
+		}
+		if hasAllFeatures(features, m.RequiredFeatures) {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+func hasAllFeatures(have, want []string) bool {
+	if len(want) == 0 {
+		return false // a template with no required_features only matches explicitly
+	}
+	set := make(map[string]bool, len(have))
+	for _, f := range have {
+		set[f] = true
+	}
+	for _, f := range want {
+		if !set[f] {
+			return false
+		}
+	}
+	return true
+}
+
+// Install fetches a manifest by name from the configured remote indexes and
+// caches it under dir, so `ai_game_dev_gallery_install "bevy-platformer-2d"`
+// makes it available to List/Get/Apply without a network round-trip on
+// every later generation.
+func (g *Gallery) Install(name string) error {
+	if _, ok := g.Get(name); ok {
+		return nil
+	}
+
+	for _, indexURL := range g.remoteIndexes {
+		manifestURL, err := g.resolveIndex(indexURL, name)
+		if err != nil || manifestURL == "" {
+			continue
+		}
+		data, err := fetchURL(manifestURL)
+		if err != nil {
+			continue
+		}
+		var manifest TemplateManifest
+		if err := yaml.Unmarshal(data, &manifest); err != nil {
+			continue
+		}
+		if manifest.Name == "" {
+			manifest.Name = name
+		}
+
+		if err := os.MkdirAll(g.dir, 0755); err == nil {
+			os.WriteFile(filepath.Join(g.dir, manifest.Name+".yaml"), data, 0644)
+		}
+
+		g.mu.Lock()
+		g.templates[manifest.Name] = &manifest
+		g.mu.Unlock()
+		return nil
+	}
+
+	return fmt.Errorf("template %q not found in any configured remote index", name)
+}
+
+func (g *Gallery) resolveIndex(indexURL, name string) (string, error) {
+	data, err := fetchURL(indexURL)
+	if err != nil {
+		return "", err
+	}
+	var entries []remoteIndexEntry
+	if err := yaml.Unmarshal(data, &entries); err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		if e.Name == name {
+			return e.URL, nil
+		}
+	}
+	return "", fmt.Errorf("%q not listed in index %s", name, indexURL)
+}
+
+func fetchURL(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// templateContext is what manifest file paths/templates are rendered
+// against: the raw description plus the GameConfig the caller supplied.
+type templateContext struct {
+	Description string
+	Config      GameConfig
+}
+
+// Apply renders every file in the named manifest's file list via
+// text/template against description/config and returns the resulting
+// paths. generateFileList uses it in preference to the static per-engine
+// lists, which it returns only when no template is selected or matched.
+func (g *Gallery) Apply(name, description string, config GameConfig) ([]string, error) {
+	manifest, ok := g.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("template %q not installed", name)
+	}
+
+	ctx := templateContext{Description: description, Config: config}
+	files := make([]string, 0, len(manifest.Files))
+	for _, f := range manifest.Files {
+		tmpl, err := template.New(f.Path).Parse(f.Path)
+		if err != nil {
+			return nil, fmt.Errorf("template %q file %q: %w", name, f.Path, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, ctx); err != nil {
+			return nil, fmt.Errorf("template %q file %q: %w", name, f.Path, err)
+		}
+		files = append(files, buf.String())
+	}
+	return files, nil
+}
@@ -0,0 +1,26 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import "encoding/json"
+
+//export ai_game_dev_gallery_list
+func ai_game_dev_gallery_list() *C.char {
+	templatesJSON, err := json.Marshal(gallery().List())
+	if err != nil {
+		return C.CString(`{"error": "Failed to serialize template gallery"}`)
+	}
+	return C.CString(string(templatesJSON))
+}
+
+//export ai_game_dev_gallery_install
+func ai_game_dev_gallery_install(name *C.char) C.int {
+	if err := gallery().Install(C.GoString(name)); err != nil {
+		lastError = err.Error()
+		return -1
+	}
+	return 0
+}
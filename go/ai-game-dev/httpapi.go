@@ -0,0 +1,408 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// sseFlushWriter is satisfied by the http.ResponseWriter implementations
+// net/http hands handlers for plain HTTP/1.1 and HTTP/2 connections.
+type sseFlushWriter interface {
+	http.ResponseWriter
+	http.Flusher
+}
+
+var httpAddr = flag.String("http-addr", "", "address to serve the OpenAI-compatible HTTP API on (disabled if empty)")
+
+// createGameRequest is the body of POST /v1/games. Engine is accepted as
+// the string name ("bevy", "godot", "arcade", "auto") rather than the
+// GameEngine enum so the API reads the same way OpenAI-style JSON bodies
+// do.
+type createGameRequest struct {
+	Description string   `json:"description"`
+	Engine      string   `json:"engine"`
+	Complexity  string   `json:"complexity"`
+	Features    []string `json:"features"`
+	Stream      bool     `json:"stream"`
+}
+
+// gameResponse wraps a GameInstance for the HTTP API, adding the id and
+// state fields that the C API instead returns via the instance handle.
+type gameResponse struct {
+	ID     int         `json:"id"`
+	State  string      `json:"state"`
+	Result *GameResult `json:"result,omitempty"`
+}
+
+// chatCompletionRequest mirrors the subset of OpenAI's chat completions
+// request body that NewHTTPServer understands: the last user message is
+// taken as the game description.
+type chatCompletionRequest struct {
+	Model    string `json:"model"`
+	Messages []struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	} `json:"messages"`
+}
+
+type chatCompletionResponse struct {
+	ID      string `json:"id"`
+	Object  string `json:"object"`
+	Model   string `json:"model"`
+	Choices []struct {
+		Index   int `json:"index"`
+		Message struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+// NewHTTPServer builds the OpenAI-compatible HTTP API. It shares
+// gameInstances with the C FFI through createGameInstance/lookupGameInstance,
+// so a game started via ai_game_dev_create_game can be polled with
+// GET /v1/games/{id} and vice-versa.
+func NewHTTPServer() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/games", handleCreateGame)
+	mux.HandleFunc("/v1/games/stream", handleCreateGameStream)
+	mux.HandleFunc("/v1/games/", handleGetGame)
+	mux.HandleFunc("/v1/games/cancel/", handleCancelGame)
+	mux.HandleFunc("/v1/games/package/", handlePackageGame)
+	mux.HandleFunc("/v1/engines", handleListEngines)
+	mux.HandleFunc("/v1/gallery", handleGalleryList)
+	mux.HandleFunc("/v1/gallery/install", handleGalleryInstall)
+	mux.HandleFunc("/v1/chat/completions", handleChatCompletions)
+	return mux
+}
+
+// StartHTTPServer starts the HTTP API on addr if addr is non-empty. It is
+// meant to be run in its own goroutine alongside the C FFI consumers.
+func StartHTTPServer(addr string) error {
+	if addr == "" {
+		return nil
+	}
+	return http.ListenAndServe(addr, NewHTTPServer())
+}
+
+// handleCreateGame blocks until generation finishes and returns the full
+// result, unless the body sets "stream": true, in which case it delegates
+// to the same SSE path as POST /v1/games/stream.
+func handleCreateGame(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req createGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if req.Stream {
+		flusher, ok := w.(sseFlushWriter)
+		if !ok {
+			writeJSONError(w, http.StatusInternalServerError, "streaming unsupported")
+			return
+		}
+		streamCreateGame(flusher, req)
+		return
+	}
+
+	config := GameConfig{
+		Engine:     engineFromName(req.Engine),
+		Complexity: req.Complexity,
+		Features:   req.Features,
+	}
+
+	instance, err := createGameInstance(req.Description, config)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, gameResponse{ID: instance.ID, State: instance.State, Result: instance.Result})
+}
+
+func handleGalleryList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]*TemplateManifest{"templates": gallery().List()})
+}
+
+func handleGalleryInstall(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	if err := gallery().Install(req.Name); err != nil {
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "installed"})
+}
+
+// handleCreateGameStream is the dedicated text/event-stream endpoint for
+// starting a generation; POST /v1/games with {"stream": true} reaches the
+// same behavior through streamCreateGame.
+func handleCreateGameStream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	flusher, ok := w.(sseFlushWriter)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	var req createGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	streamCreateGame(flusher, req)
+}
+
+// streamCreateGame drives a game generation to completion over SSE, emitting
+// one `data: <GenerationEvent JSON>` line per pipeline step. It backs both
+// POST /v1/games/stream and POST /v1/games with {"stream": true}.
+func streamCreateGame(w sseFlushWriter, req createGameRequest) {
+	config := GameConfig{
+		Engine:     engineFromName(req.Engine),
+		Complexity: req.Complexity,
+		Features:   req.Features,
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	instance, events := newGameInstance(req.Description, config)
+	drainGeneration(instance, events, func(event GenerationEvent) {
+		eventJSON, err := json.Marshal(event)
+		if err != nil {
+			return
+		}
+		w.Write([]byte("data: "))
+		w.Write(eventJSON)
+		w.Write([]byte("\n\n"))
+		w.Flush()
+	})
+}
+
+// handleCancelGame cancels an in-flight streamed generation, mirroring
+// ai_game_dev_cancel for HTTP clients.
+func handleCancelGame(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	idStr := strings.TrimPrefix(r.URL.Path, "/v1/games/cancel/")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid game id")
+		return
+	}
+
+	instance, ok := lookupGameInstance(id)
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	instancesMu.Lock()
+	if instance.State == "generating" {
+		instance.cancelRequested = true
+	}
+	cancel := instance.cancel
+	instancesMu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	writeJSON(w, http.StatusOK, gameResponse{ID: instance.ID, State: instance.State})
+}
+
+// handlePackageGame is the HTTP counterpart of ai_game_dev_package:
+// POST /v1/games/package/{id} with {"format": "zip"|"steampipe"|"itch-butler"|"appimage"}.
+func handlePackageGame(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	idStr := strings.TrimPrefix(r.URL.Path, "/v1/games/package/")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid game id")
+		return
+	}
+
+	instance, ok := lookupGameInstance(id)
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, "game not found")
+		return
+	}
+	if !instance.Generated || instance.Result == nil {
+		writeJSONError(w, http.StatusConflict, "game not yet generated")
+		return
+	}
+
+	var req struct {
+		Format string `json:"format"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	path, err := PackageProject(instance.Result.OutputDirectory, PackageFormat(req.Format))
+	if err != nil {
+		writeJSONError(w, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	instancesMu.Lock()
+	if instance.Packages == nil {
+		instance.Packages = map[string]string{}
+	}
+	instance.Packages[req.Format] = path
+	instancesMu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]string{"path": path})
+}
+
+func handleGetGame(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	idStr := strings.TrimPrefix(r.URL.Path, "/v1/games/")
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid game id")
+		return
+	}
+
+	instance, ok := lookupGameInstance(id)
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, "game not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, gameResponse{ID: instance.ID, State: instance.State, Result: instance.Result})
+}
+
+func handleListEngines(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]string{"engines": GetSupportedEngines()})
+}
+
+// handleChatCompletions translates a chat-style request into a game
+// generation request, using the last user message as the description, so
+// the module can be dropped into UIs that already speak the OpenAI chat
+// completions API.
+func handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req chatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	description := lastUserMessage(req)
+	instance, err := createGameInstance(description, GameConfig{Engine: EngineAuto})
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp := chatCompletionResponse{
+		ID:     "game-" + strconv.Itoa(instance.ID),
+		Object: "chat.completion",
+		Model:  req.Model,
+	}
+	resp.Choices = make([]struct {
+		Index   int `json:"index"`
+		Message struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	}, 1)
+	resp.Choices[0].Index = 0
+	resp.Choices[0].Message.Role = "assistant"
+	resp.Choices[0].Message.Content = chatReply(instance)
+	resp.Choices[0].FinishReason = "stop"
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func chatReply(instance *GameInstance) string {
+	if instance.Result == nil {
+		return "Generation failed."
+	}
+	return "Created \"" + instance.Result.Title + "\" (" + instance.Result.Engine + ") in " + instance.Result.OutputDirectory
+}
+
+func lastUserMessage(req chatCompletionRequest) string {
+	for i := len(req.Messages) - 1; i >= 0; i-- {
+		if req.Messages[i].Role == "user" {
+			return req.Messages[i].Content
+		}
+	}
+	return ""
+}
+
+func engineFromName(name string) GameEngine {
+	switch strings.ToLower(name) {
+	case "bevy":
+		return EngineBevy
+	case "godot":
+		return EngineGodot
+	case "arcade":
+		return EngineArcade
+	default:
+		return EngineAuto
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
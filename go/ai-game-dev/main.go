@@ -1,13 +1,34 @@
 // Package main provides the core C-compatible interface for ai-game-dev
 package main
 
+/*
+#include <stdlib.h>
+
+// ai_game_dev_event_callback receives one JSON-encoded GenerationEvent per
+// call, as emitted by ai_game_dev_create_game_stream.
+typedef void (*ai_game_dev_event_callback)(const char* eventJSON, void* userData);
+
+static inline void ai_game_dev_invoke_callback(ai_game_dev_event_callback cb, const char* eventJSON, void* userData) {
+	cb(eventJSON, userData);
+}
+*/
 import "C"
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"unsafe"
+
+	"ai-game-dev/go/ai-game-dev/backend"
 )
 
 // GameEngine represents supported game engines
@@ -26,6 +47,12 @@ type GameConfig struct {
 	Complexity     string     `json:"complexity"`
 	TargetAudience string     `json:"target_audience"`
 	Features       []string   `json:"features"`
+	// Template explicitly selects a gallery template by name, bypassing
+	// feature matching (see Gallery.MatchFeatures).
+	Template string `json:"template,omitempty"`
+	// Assets requests sprite/voice/music generation alongside the
+	// scaffolded files; see AssetDispatcher.
+	Assets AssetsConfig `json:"assets,omitempty"`
 }
 
 // GameResult contains the result of game creation
@@ -37,23 +64,124 @@ type GameResult struct {
 	FilesGenerated  []string `json:"files_generated"`
 	OutputDirectory string   `json:"output_directory"`
 	ErrorMessage    string   `json:"error_message,omitempty"`
+
+	Distribution *DistributionManifest `json:"distribution,omitempty"`
 }
 
 // Global state management
 var (
-	initialized    bool
-	lastError      string
+	initialized bool
+	lastError   string
+
+	// instancesMu guards gameInstances and nextInstanceID, which are now
+	// shared between the C FFI and the HTTP API: a game started via
+	// ai_game_dev_create_game can be polled over HTTP and vice-versa.
+	instancesMu    sync.Mutex
 	gameInstances  = make(map[int]*GameInstance)
 	nextInstanceID int
+
+	// engineRegistry holds gRPC connections to external backends, spawned
+	// by Spawn/DiscoverDir or dialed remotely. Consulted for any engine
+	// name that isn't one of the builtin generators below.
+	engineRegistry = backend.NewRegistry()
+
+	externalBackends     = flag.Bool("external-backends", false, "enable discovery and use of external gRPC engine backends")
+	externalBackendsDir  = flag.String("external-backends-dir", "./backends", "directory autoloaded for external engine backend executables")
+	externalBackendsSock = flag.String("external-backends-socket-dir", os.TempDir(), "directory used for external backend unix sockets")
+
+	galleryDir           = flag.String("gallery-dir", "./templates", "directory of local/cached game template manifests")
+	galleryRemoteIndexes = flag.String("gallery-remote-indexes", "", "comma-separated list of remote gallery index URLs")
+
+	// templateGallery is populated lazily on first use so the flag values
+	// above have been parsed (they aren't available at package init time).
+	templateGallery     *Gallery
+	templateGalleryOnce sync.Once
+
+	spriteBackendURL = flag.String("sprite-backend-url", "", "HTTP endpoint of the Stable-Diffusion-style sprite backend")
+	voiceBackendURL  = flag.String("voice-backend-url", "", "HTTP endpoint of the TTS voice-line backend")
+	musicBackendURL  = flag.String("music-backend-url", "", "HTTP endpoint of the MusicGen-style music backend")
+	spriteModel      = flag.String("sprite-model", "", "model name to request from the sprite backend")
+	voiceModel       = flag.String("voice-model", "", "model name to request from the voice backend")
+	musicModel       = flag.String("music-model", "", "model name to request from the music backend")
+	assetCacheDir    = flag.String("asset-cache-dir", filepath.Join(os.TempDir(), "ai-game-dev-asset-cache"), "shared on-disk cache for generated assets, keyed by prompt+model")
+	assetsDryRun     = flag.Bool("assets-dry-run", false, "write prompt manifests instead of calling asset backends (deterministic for tests)")
+
+	assetDispatcher     *AssetDispatcher
+	assetDispatcherOnce sync.Once
 )
 
+// dispatcher returns the package-wide AssetDispatcher, building it on first
+// use from the --sprite/voice/music-backend-url and --assets-dry-run flags.
+func dispatcher() *AssetDispatcher {
+	assetDispatcherOnce.Do(func() {
+		backends := map[AssetKind]AssetBackend{}
+		if *spriteBackendURL != "" {
+			backends[AssetSprite] = &httpAssetBackend{url: *spriteBackendURL, model: *spriteModel}
+		}
+		if *voiceBackendURL != "" {
+			backends[AssetVoice] = &httpAssetBackend{url: *voiceBackendURL, model: *voiceModel}
+		}
+		if *musicBackendURL != "" {
+			backends[AssetMusic] = &httpAssetBackend{url: *musicBackendURL, model: *musicModel}
+		}
+		model := map[AssetKind]string{AssetSprite: *spriteModel, AssetVoice: *voiceModel, AssetMusic: *musicModel}
+		assetDispatcher = NewAssetDispatcher(backends, NewAssetCache(*assetCacheDir), *assetsDryRun, model)
+	})
+	return assetDispatcher
+}
+
+// gallery returns the package-wide Gallery, building it on first use from
+// the --gallery-dir/--gallery-remote-indexes flags.
+func gallery() *Gallery {
+	templateGalleryOnce.Do(func() {
+		templateGallery = NewGallery(*galleryDir, splitNonEmpty(*galleryRemoteIndexes, ","))
+	})
+	return templateGallery
+}
+
+func splitNonEmpty(s, sep string) []string {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, sep)
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// builtinEngines maps an engine name to its in-process file generator. This
+// replaces the old `switch config.Engine` blocks: adding a new builtin
+// engine is a map entry, and engines that aren't builtin fall through to
+// engineRegistry so third-party backends (Unity, LÖVE, Construct, ...) can
+// be plugged in without touching this file at all.
+var builtinEngines = map[string]func(string) []string{
+	"bevy":   generateBevyFiles,
+	"godot":  generateGodotFiles,
+	"arcade": generateArcadeFiles,
+}
+
 // GameInstance represents an active game development session
 type GameInstance struct {
-	ID          int
-	Config      GameConfig
-	State       string
-	Generated   bool
-	Result      *GameResult
+	ID        int
+	Config    GameConfig
+	State     string
+	Generated bool
+	Result    *GameResult
+
+	// cancel stops an in-flight generateGameStream pipeline; set by
+	// createGameInstance/ai_game_dev_create_game_stream and invoked by
+	// ai_game_dev_cancel, which also sets cancelRequested so the pipeline's
+	// resulting error event is reported as "cancelled" rather than "failed".
+	cancel          context.CancelFunc
+	cancelRequested bool
+
+	// Packages records the artifact path produced by each ai_game_dev_package
+	// call for this instance, keyed by format.
+	Packages map[string]string
 }
 
 //export ai_game_dev_init
@@ -64,9 +192,28 @@ func ai_game_dev_init() C.int {
 	
 	// Initialize the library
 	runtime.GC() // Ensure clean start
+
+	if !flag.Parsed() {
+		flag.Parse()
+	}
+	if *externalBackends {
+		if err := engineRegistry.DiscoverDir(context.Background(), *externalBackendsDir, *externalBackendsSock); err != nil {
+			lastError = "Failed to discover external backends: " + err.Error()
+			return -1
+		}
+	}
+
+	if *httpAddr != "" {
+		go func() {
+			if err := StartHTTPServer(*httpAddr); err != nil {
+				lastError = "HTTP API server stopped: " + err.Error()
+			}
+		}()
+	}
+
 	initialized = true
 	lastError = ""
-	
+
 	return 0
 }
 
@@ -86,30 +233,118 @@ func ai_game_dev_create_game(description *C.char, configJSON *C.char) C.int {
 		return -1
 	}
 	
-	// Create new game instance
+	instance, err := createGameInstance(desc, config)
+	if err != nil {
+		lastError = err.Error()
+		return -1
+	}
+
+	return C.int(instance.ID)
+}
+
+//export ai_game_dev_create_game_stream
+func ai_game_dev_create_game_stream(description *C.char, configJSON *C.char, callback C.ai_game_dev_event_callback, userData unsafe.Pointer) C.int {
+	if !initialized {
+		lastError = "Library not initialized"
+		return -1
+	}
+
+	desc := C.GoString(description)
+	configStr := C.GoString(configJSON)
+
+	var config GameConfig
+	if err := json.Unmarshal([]byte(configStr), &config); err != nil {
+		lastError = "Invalid configuration JSON: " + err.Error()
+		return -1
+	}
+
+	instance, events := newGameInstance(desc, config)
+
+	go drainGeneration(instance, events, func(event GenerationEvent) {
+		eventJSON, err := json.Marshal(event)
+		if err != nil {
+			return
+		}
+		cEventJSON := C.CString(string(eventJSON))
+		C.ai_game_dev_invoke_callback(callback, cEventJSON, userData)
+		C.free(unsafe.Pointer(cEventJSON))
+	})
+
+	return C.int(instance.ID)
+}
+
+// createGameInstance allocates a new GameInstance, runs generation
+// synchronously, and stores it in the shared gameInstances registry. Both
+// the C exports and the HTTP API (POST /v1/games) go through this so a
+// game started on either frontend is visible to the other.
+func createGameInstance(description string, config GameConfig) (*GameInstance, error) {
+	instance, events := newGameInstance(description, config)
+	return instance, drainGeneration(instance, events, nil)
+}
+
+// newGameInstance registers a new GameInstance and starts its generation
+// pipeline, returning both the instance and its event channel so callers
+// can either drain it synchronously (createGameInstance) or forward each
+// event to a callback/SSE stream (ai_game_dev_create_game_stream).
+func newGameInstance(description string, config GameConfig) (*GameInstance, <-chan GenerationEvent) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	instancesMu.Lock()
 	instanceID := nextInstanceID
 	nextInstanceID++
-	
 	instance := &GameInstance{
 		ID:     instanceID,
 		Config: config,
 		State:  "generating",
+		cancel: cancel,
 	}
-	
-	// Generate the game (simplified for C interface)
-	result, err := generateGame(desc, config)
-	if err != nil {
-		lastError = err.Error()
-		return -1
-	}
-	
-	instance.Result = result
-	instance.Generated = true
-	instance.State = "completed"
-	
 	gameInstances[instanceID] = instance
-	
-	return C.int(instanceID)
+	instancesMu.Unlock()
+
+	return instance, generateGameStream(ctx, description, config)
+}
+
+// drainGeneration consumes events until the pipeline finishes, updating
+// instance accordingly. If onEvent is non-nil it is called for every event
+// (used to forward progress to a C callback or an SSE response).
+func drainGeneration(instance *GameInstance, events <-chan GenerationEvent, onEvent func(GenerationEvent)) error {
+	var genErr error
+	for event := range events {
+		if onEvent != nil {
+			onEvent(event)
+		}
+		switch event.Type {
+		case "completed":
+			instancesMu.Lock()
+			instance.Result = event.Result
+			instance.Generated = true
+			instance.State = "completed"
+			instancesMu.Unlock()
+		case "error":
+			genErr = errors.New(event.Message)
+		}
+	}
+
+	if genErr != nil {
+		instancesMu.Lock()
+		if instance.State != "completed" {
+			if instance.cancelRequested {
+				instance.State = "cancelled"
+			} else {
+				instance.State = "failed"
+			}
+		}
+		instancesMu.Unlock()
+	}
+	return genErr
+}
+
+// lookupGameInstance returns the instance registered under id, if any.
+func lookupGameInstance(id int) (*GameInstance, bool) {
+	instancesMu.Lock()
+	defer instancesMu.Unlock()
+	instance, ok := gameInstances[id]
+	return instance, ok
 }
 
 //export ai_game_dev_get_result
@@ -117,28 +352,56 @@ func ai_game_dev_get_result(instanceID C.int) *C.char {
 	if !initialized {
 		return C.CString(`{"error": "Library not initialized"}`)
 	}
-	
-	instance, exists := gameInstances[int(instanceID)]
+
+	instance, exists := lookupGameInstance(int(instanceID))
 	if !exists {
 		return C.CString(`{"error": "Invalid instance ID"}`)
 	}
-	
+
 	if !instance.Generated {
 		return C.CString(`{"error": "Game not yet generated"}`)
 	}
-	
+
 	resultJSON, err := json.Marshal(instance.Result)
 	if err != nil {
 		return C.CString(`{"error": "Failed to serialize result"}`)
 	}
-	
+
 	return C.CString(string(resultJSON))
 }
 
+//export ai_game_dev_cancel
+func ai_game_dev_cancel(instanceID C.int) C.int {
+	if !initialized {
+		lastError = "Library not initialized"
+		return -1
+	}
+
+	instance, exists := lookupGameInstance(int(instanceID))
+	if !exists {
+		lastError = "Invalid instance ID"
+		return -1
+	}
+
+	instancesMu.Lock()
+	if instance.State != "generating" {
+		instancesMu.Unlock()
+		return 0
+	}
+	instance.cancelRequested = true
+	cancel := instance.cancel
+	instancesMu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	return 0
+}
+
 //export ai_game_dev_supported_engines
 func ai_game_dev_supported_engines() *C.char {
-	engines := []string{"bevy", "godot", "arcade", "auto"}
-	enginesJSON, _ := json.Marshal(engines)
+	enginesJSON, _ := json.Marshal(GetSupportedEngines())
 	return C.CString(string(enginesJSON))
 }
 
@@ -159,10 +422,12 @@ func ai_game_dev_cleanup() {
 	}
 	
 	// Clean up all game instances
+	instancesMu.Lock()
 	for id := range gameInstances {
 		delete(gameInstances, id)
 	}
-	
+	instancesMu.Unlock()
+
 	initialized = false
 	lastError = ""
 	nextInstanceID = 0
@@ -171,35 +436,41 @@ func ai_game_dev_cleanup() {
 	runtime.GC()
 }
 
-// generateGame creates a game based on description and configuration
+// generateGame runs generateGameStream to completion and returns its final
+// result, for callers (CreateGame, runCLI) that want a blocking call rather
+// than a channel of progress events.
 func generateGame(description string, config GameConfig) (*GameResult, error) {
-	if len(description) == 0 {
-		return nil, errors.New("description cannot be empty")
+	for event := range generateGameStream(context.Background(), description, config) {
+		switch event.Type {
+		case "completed":
+			return event.Result, nil
+		case "error":
+			return nil, errors.New(event.Message)
+		}
 	}
-	
-	// Determine engine
-	engineName := "auto"
-	switch config.Engine {
+	return nil, errors.New("generation produced no result")
+}
+
+// dispatchAssets runs config.Assets's sprite/voice/music jobs through the
+// package-wide AssetDispatcher, writing results under outputDir. It is a
+// no-op (nil, nil) when config.Assets has no jobs.
+func dispatchAssets(ctx context.Context, outputDir string, config GameConfig) ([]string, error) {
+	return dispatcher().Dispatch(ctx, outputDir, config.Assets)
+}
+
+// engineNameOf converts the GameEngine enum to the string key used to look
+// up a backend in builtinEngines/engineRegistry.
+func engineNameOf(engine GameEngine) string {
+	switch engine {
 	case EngineBevy:
-		engineName = "bevy"
+		return "bevy"
 	case EngineGodot:
-		engineName = "godot"
+		return "godot"
 	case EngineArcade:
-		engineName = "arcade"
-	}
-	
-	// Generate basic game structure
-	result := &GameResult{
-		Title:           generateTitle(description),
-		Description:     description,
-		Engine:          engineName,
-		Success:         true,
-		FilesGenerated:  generateFileList(engineName),
-		OutputDirectory: "./generated_games/" + sanitizeFilename(description),
-		ErrorMessage:    "",
+		return "arcade"
+	default:
+		return "auto"
 	}
-	
-	return result, nil
 }
 
 func generateTitle(description string) string {
@@ -220,38 +491,70 @@ func generateTitle(description string) string {
 	return title
 }
 
-func generateFileList(engine string) []string {
-	switch engine {
-	case "bevy":
-		return []string{
-			"Cargo.toml",
-			"src/main.rs", 
-			"src/components.rs",
-			"src/systems.rs",
-			"src/resources.rs",
-			"assets/sprites/",
-			"assets/audio/",
+// generateFileList resolves engine to a backend and returns the files it
+// would scaffold. Builtin engines are looked up in builtinEngines; anything
+// else falls through to engineRegistry, which is only populated when
+// --external-backends discovers or dials a matching gRPC backend.
+// generateFileList picks a game template from the gallery (explicitly via
+// config.Template, or by matching config.Features) and renders its files.
+// If no template matches, it falls back to the builtin/registry engine
+// lookup so engines without a gallery entry still work.
+func generateFileList(engine, description string, config GameConfig) ([]string, error) {
+	if name := config.Template; name != "" {
+		if _, ok := gallery().Get(name); !ok {
+			return nil, fmt.Errorf("template %q not installed (use ai_game_dev_gallery_install first)", name)
 		}
-	case "godot":
-		return []string{
-			"project.godot",
-			"scenes/Main.tscn",
-			"scripts/Main.gd",
-			"scripts/Player.gd",
-			"assets/sprites/",
-			"assets/audio/",
-		}
-	case "arcade":
-		return []string{
-			"main.py",
-			"game.py",
-			"sprites.py",
-			"assets/",
-			"requirements.txt",
-			"web_config.json",
+		return gallery().Apply(name, description, config)
+	}
+	if manifest, ok := gallery().MatchFeatures(engine, config.Features); ok {
+		return gallery().Apply(manifest.Name, description, config)
+	}
+
+	if gen, ok := builtinEngines[engine]; ok {
+		return gen(description), nil
+	}
+
+	b, ok := engineRegistry.Lookup(engine)
+	if !ok {
+		if engine == "auto" {
+			return []string{"main.py", "assets/"}, nil
 		}
-	default:
-		return []string{"main.py", "assets/"}
+		return nil, fmt.Errorf("no backend registered for engine %q (pass --external-backends and drop a matching binary in the backends directory)", engine)
+	}
+	return generateViaBackend(b, description, config)
+}
+
+func generateBevyFiles(description string) []string {
+	return []string{
+		"Cargo.toml",
+		"src/main.rs",
+		"src/components.rs",
+		"src/systems.rs",
+		"src/resources.rs",
+		"assets/sprites/",
+		"assets/audio/",
+	}
+}
+
+func generateGodotFiles(description string) []string {
+	return []string{
+		"project.godot",
+		"scenes/Main.tscn",
+		"scripts/Main.gd",
+		"scripts/Player.gd",
+		"assets/sprites/",
+		"assets/audio/",
+	}
+}
+
+func generateArcadeFiles(description string) []string {
+	return []string{
+		"main.py",
+		"game.py",
+		"sprites.py",
+		"assets/",
+		"requirements.txt",
+		"web_config.json",
 	}
 }
 
@@ -278,8 +581,17 @@ func CreateGame(description string, config GameConfig) (*GameResult, error) {
 	return generateGame(description, config)
 }
 
+// GetSupportedEngines lists every engine name the module can generate for:
+// "auto" plus whatever builtinEngines/engineRegistry currently know about,
+// sorted so repeated calls return a stable order.
 func GetSupportedEngines() []string {
-	return []string{"bevy", "godot", "arcade", "auto"}
+	var names []string
+	for name := range builtinEngines {
+		names = append(names, name)
+	}
+	names = append(names, engineRegistry.Engines()...)
+	sort.Strings(names)
+	return append([]string{"auto"}, names...)
 }
 
 func main() {
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// projectIndexVersion is bumped whenever the ProjectIndex shape changes in
+// a way that matters to ai_game_dev_open_project/ai_game_dev_regenerate.
+const projectIndexVersion = 1
+
+// projectIndexFilename is the file written into OutputDirectory by
+// generateGame/generateGameStream and read back by LoadProjectIndex.
+const projectIndexFilename = "index.json"
+
+// ProjectIndex is the persisted form of a GameInstance, written to
+// index.json so a generation session can be resumed or partially
+// regenerated after the process exits.
+type ProjectIndex struct {
+	Version         int               `json:"version"`
+	Config          GameConfig        `json:"config"`
+	Description     string            `json:"description"`
+	Title           string            `json:"title"`
+	Files           []string          `json:"files"`
+	OutputDirectory string            `json:"output_directory"`
+	BackendVersions map[string]string `json:"backend_versions,omitempty"`
+	AssetPrompts    map[string]string `json:"asset_prompts,omitempty"`
+	GenerationLog   []string          `json:"generation_log,omitempty"`
+}
+
+// buildProjectIndex captures a finished generation so it can be persisted
+// next to the project's output.
+func buildProjectIndex(description string, config GameConfig, result *GameResult, log []string) *ProjectIndex {
+	return &ProjectIndex{
+		Version:         projectIndexVersion,
+		Config:          config,
+		Description:     description,
+		Title:           result.Title,
+		Files:           result.FilesGenerated,
+		OutputDirectory: result.OutputDirectory,
+		BackendVersions: map[string]string{"ai-game-dev": "1.0.0"},
+		AssetPrompts:    assetPromptsFor(config),
+		GenerationLog:   log,
+	}
+}
+
+func assetPromptsFor(config GameConfig) map[string]string {
+	prompts := map[string]string{}
+	for _, job := range jobsFor(config.Assets) {
+		prompts[job.OutputPath] = job.Prompt
+	}
+	if len(prompts) == 0 {
+		return nil
+	}
+	return prompts
+}
+
+// writeProjectIndex marshals idx to index.json inside outputDir.
+func writeProjectIndex(outputDir string, idx *ProjectIndex) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outputDir, projectIndexFilename), data, 0644)
+}
+
+// LoadProjectIndex reads a ProjectIndex from source, which may be a path
+// (string), an already-open io.Reader, or nil to default to index.json in
+// the current working directory — mirroring the flexible config-loading
+// pattern used elsewhere for loading generation config from varied
+// sources.
+func LoadProjectIndex(source interface{}) (*ProjectIndex, error) {
+	var r io.Reader
+	switch v := source.(type) {
+	case nil:
+		f, err := os.Open(projectIndexFilename)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", projectIndexFilename, err)
+		}
+		defer f.Close()
+		r = f
+	case string:
+		f, err := os.Open(v)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", v, err)
+		}
+		defer f.Close()
+		r = f
+	case io.Reader:
+		r = v
+	default:
+		return nil, fmt.Errorf("unsupported project index source type %T", source)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read project index: %w", err)
+	}
+
+	var idx ProjectIndex
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("parse project index: %w", err)
+	}
+	return &idx, nil
+}
+
+// OpenProject loads a ProjectIndex and rehydrates it into a GameInstance
+// registered in gameInstances, so it can be polled/regenerated like any
+// instance created by createGameInstance.
+func OpenProject(source interface{}) (*GameInstance, error) {
+	idx, err := LoadProjectIndex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GameResult{
+		Title:           idx.Title,
+		Description:     idx.Description,
+		Engine:          engineNameOf(idx.Config.Engine),
+		Success:         true,
+		FilesGenerated:  idx.Files,
+		OutputDirectory: idx.OutputDirectory,
+	}
+
+	instancesMu.Lock()
+	instanceID := nextInstanceID
+	nextInstanceID++
+	instance := &GameInstance{
+		ID:        instanceID,
+		Config:    idx.Config,
+		State:     "completed",
+		Generated: true,
+		Result:    result,
+	}
+	gameInstances[instanceID] = instance
+	instancesMu.Unlock()
+
+	return instance, nil
+}
+
+// Regenerate reruns a single part of an already-generated project,
+// selected either by one of its file paths (e.g. "src/systems.rs") or, for
+// asset jobs, "sprite:<name>" / "voice:<character>" / "music:<scene>".
+// Everything else about the project is left untouched, and index.json is
+// rewritten with an updated generation_log entry.
+func Regenerate(instanceID int, selector string) error {
+	instance, ok := lookupGameInstance(instanceID)
+	if !ok {
+		return fmt.Errorf("invalid instance ID %d", instanceID)
+	}
+	if !instance.Generated || instance.Result == nil {
+		return fmt.Errorf("instance %d has not been generated yet", instanceID)
+	}
+
+	logEntry := fmt.Sprintf("regenerate %q", selector)
+
+	if kind, name, ok := strings.Cut(selector, ":"); ok && isAssetSelector(kind) {
+		if err := regenerateAsset(instance, AssetKind(kind), name); err != nil {
+			return err
+		}
+	} else {
+		if !containsString(instance.Result.FilesGenerated, selector) {
+			return fmt.Errorf("file %q is not part of instance %d", selector, instanceID)
+		}
+		// The generator only tracks scaffolded paths rather than file
+		// contents, so regenerating a single file just re-derives its path
+		// from the same template/engine that produced it originally.
+		files, err := generateFileList(instance.Result.Engine, instance.Result.Description, instance.Config)
+		if err != nil {
+			return err
+		}
+		if !containsString(files, selector) {
+			return fmt.Errorf("regenerating %q produced a different file set; the template or config may have changed", selector)
+		}
+	}
+
+	instancesMu.Lock()
+	instance.Result.Success = true
+	instancesMu.Unlock()
+
+	idx := buildProjectIndex(instance.Result.Description, instance.Config, instance.Result, append(projectLog(instance), logEntry))
+	return writeProjectIndex(instance.Result.OutputDirectory, idx)
+}
+
+func isAssetSelector(kind string) bool {
+	switch AssetKind(kind) {
+	case AssetSprite, AssetVoice, AssetMusic:
+		return true
+	default:
+		return false
+	}
+}
+
+// regenerateAsset re-dispatches the sprite/voice/music jobs from the
+// instance's original AssetsConfig whose name (sprite), character (voice),
+// or scene (music) equals name, writing the results over the existing
+// files. A voice selector therefore covers every line of that character.
+func regenerateAsset(instance *GameInstance, kind AssetKind, name string) error {
+	filtered := AssetsConfig{
+		Sprites: filterSprites(instance.Config.Assets.Sprites, kind, name),
+		Voices:  filterVoices(instance.Config.Assets.Voices, kind, name),
+		Music:   filterMusic(instance.Config.Assets.Music, kind, name),
+	}
+	if len(filtered.Sprites) == 0 && len(filtered.Voices) == 0 && len(filtered.Music) == 0 {
+		return fmt.Errorf("no %s asset named %q on instance %d", kind, name, instance.ID)
+	}
+
+	_, err := dispatcher().Dispatch(context.Background(), instance.Result.OutputDirectory, filtered)
+	return err
+}
+
+func filterSprites(sprites []SpriteSpec, kind AssetKind, name string) []SpriteSpec {
+	if kind != AssetSprite {
+		return nil
+	}
+	var out []SpriteSpec
+	for _, s := range sprites {
+		if s.Name == name {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func filterVoices(voices []VoiceSpec, kind AssetKind, name string) []VoiceSpec {
+	if kind != AssetVoice {
+		return nil
+	}
+	var out []VoiceSpec
+	for _, v := range voices {
+		if v.Character == name {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func filterMusic(music []MusicSpec, kind AssetKind, name string) []MusicSpec {
+	if kind != AssetMusic {
+		return nil
+	}
+	var out []MusicSpec
+	for _, m := range music {
+		if m.Scene == name {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func containsString(items []string, target string) bool {
+	for _, it := range items {
+		if it == target {
+			return true
+		}
+	}
+	return false
+}
+
+// projectLog reads back the generation_log already on disk (if any) so
+// Regenerate appends to it instead of replacing it.
+func projectLog(instance *GameInstance) []string {
+	idx, err := LoadProjectIndex(filepath.Join(instance.Result.OutputDirectory, projectIndexFilename))
+	if err != nil {
+		return nil
+	}
+	return idx.GenerationLog
+}
@@ -0,0 +1,42 @@
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+//export ai_game_dev_open_project
+func ai_game_dev_open_project(path *C.char) C.int {
+	if !initialized {
+		lastError = "Library not initialized"
+		return -1
+	}
+
+	var source interface{}
+	if p := C.GoString(path); p != "" {
+		source = p
+	}
+
+	instance, err := OpenProject(source)
+	if err != nil {
+		lastError = err.Error()
+		return -1
+	}
+
+	return C.int(instance.ID)
+}
+
+//export ai_game_dev_regenerate
+func ai_game_dev_regenerate(instanceID C.int, selector *C.char) C.int {
+	if !initialized {
+		lastError = "Library not initialized"
+		return -1
+	}
+
+	if err := Regenerate(int(instanceID), C.GoString(selector)); err != nil {
+		lastError = err.Error()
+		return -1
+	}
+
+	return 0
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+)
+
+// GenerationEvent is one step of a streaming generation, emitted over both
+// the C callback API (ai_game_dev_create_game_stream) and the HTTP SSE
+// endpoint as progress moves through design, code scaffolding, and asset
+// stub creation.
+type GenerationEvent struct {
+	Type    string `json:"type"` // phase_started, file_written, token, warning, completed, error
+	Phase   string `json:"phase,omitempty"`
+	File    string `json:"file,omitempty"`
+	Token   string `json:"token,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	Result *GameResult `json:"result,omitempty"`
+}
+
+// generateGameStream runs game generation as a pipeline of stages, emitting a
+// GenerationEvent for each step on the returned channel. The channel is
+// closed once a "completed" or "error" event has been sent. Cancelling ctx
+// (via ai_game_dev_cancel) stops the pipeline between stages/files and
+// emits a final error event.
+func generateGameStream(ctx context.Context, description string, config GameConfig) <-chan GenerationEvent {
+	events := make(chan GenerationEvent, 8)
+
+	go func() {
+		defer close(events)
+
+		if len(description) == 0 {
+			events <- GenerationEvent{Type: "error", Message: "description cannot be empty"}
+			return
+		}
+
+		events <- GenerationEvent{Type: "phase_started", Phase: "design"}
+		if ctx.Err() != nil {
+			events <- GenerationEvent{Type: "error", Message: "generation cancelled"}
+			return
+		}
+		title := generateTitle(description)
+		events <- GenerationEvent{Type: "token", Phase: "design", Token: title}
+
+		engineName := engineNameOf(config.Engine)
+
+		events <- GenerationEvent{Type: "phase_started", Phase: "code_scaffolding"}
+		files, err := generateFileList(engineName, description, config)
+		if err != nil {
+			events <- GenerationEvent{Type: "error", Message: err.Error()}
+			return
+		}
+
+		for _, file := range files {
+			if ctx.Err() != nil {
+				events <- GenerationEvent{Type: "error", Message: "generation cancelled"}
+				return
+			}
+			events <- GenerationEvent{Type: "file_written", Phase: "code_scaffolding", File: file}
+		}
+
+		events <- GenerationEvent{Type: "phase_started", Phase: "asset_stub_creation"}
+		if ctx.Err() != nil {
+			events <- GenerationEvent{Type: "error", Message: "generation cancelled"}
+			return
+		}
+
+		outputDir := "./generated_games/" + sanitizeFilename(description)
+		assetFiles, err := dispatchAssets(ctx, outputDir, config)
+		if err != nil {
+			events <- GenerationEvent{Type: "error", Message: err.Error()}
+			return
+		}
+		for _, file := range assetFiles {
+			events <- GenerationEvent{Type: "file_written", Phase: "asset_stub_creation", File: file}
+		}
+		files = append(files, assetFiles...)
+
+		events <- GenerationEvent{Type: "phase_started", Phase: "distribution"}
+		distribution := buildDistributionManifest(engineName, files)
+		if err := writeDistributionManifest(outputDir, distribution); err != nil {
+			events <- GenerationEvent{Type: "error", Message: err.Error()}
+			return
+		}
+
+		result := &GameResult{
+			Title:           title,
+			Description:     description,
+			Engine:          engineName,
+			Success:         true,
+			FilesGenerated:  files,
+			Distribution:    distribution,
+			OutputDirectory: outputDir,
+		}
+
+		idx := buildProjectIndex(description, config, result, []string{"generate"})
+		if err := writeProjectIndex(outputDir, idx); err != nil {
+			events <- GenerationEvent{Type: "error", Message: err.Error()}
+			return
+		}
+
+		events <- GenerationEvent{Type: "completed", Result: result}
+	}()
+
+	return events
+}